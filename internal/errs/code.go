@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Code is a stable, machine-readable identifier for a class of diagnostic produced by
+// the constructors in this package. Unlike Summary/Detail text, which may be reworded
+// between releases, a Code is safe for downstream consumers (including acceptance tests
+// across this repository) to match against.
+type Code string
+
+const (
+	CodeInvalidValue      Code = "invalid_value"
+	CodeInvalidValueType  Code = "invalid_value_type"
+	CodeAttributeConflict Code = "attribute_conflict"
+	CodeAttributeRequired Code = "attribute_required"
+	CodeAtLeastOneOf      Code = "at_least_one_of"
+)
+
+// withCode records code on d's Diagnostic wrapper. Earlier revisions embedded a
+// "[code=...]" marker straight into Detail, but Detail is rendered verbatim to the user
+// in terraform plan/apply output, so that silently changed the on-screen text of every
+// pre-existing constructor in this file. Recording the code on the wrapper instead keeps
+// it out of Detail entirely, the same way Range and FunctionArgument are carried.
+func withCode(d diag.Diagnostic, code Code) Diagnostic {
+	return Diagnostic{Diagnostic: d, Code: &code}
+}
+
+// DiagnosticCode returns the Code recorded for d, if any, and whether one was found.
+func DiagnosticCode(d Diagnostic) (Code, bool) {
+	if d.Code == nil {
+		return "", false
+	}
+	return *d.Code, true
+}
+
+// willBeErrorSuffix is appended to Detail by willBeError. isWillBeError checks for it
+// directly instead of via a side table, so the result does not depend on Severity; it
+// is lost if anything is appended to Detail afterward.
+const willBeErrorSuffix = "\n\nThis will be an error in a future release."
+
+func isWillBeError(d diag.Diagnostic) bool {
+	return strings.HasSuffix(d.Detail, willBeErrorSuffix)
+}
+
+// jsonDiagnostic is the machine-readable representation of a single Diagnostic produced
+// by MarshalJSON.
+type jsonDiagnostic struct {
+	Severity    string `json:"severity"`
+	Code        string `json:"code,omitempty"`
+	Summary     string `json:"summary"`
+	Detail      string `json:"detail"`
+	Path        string `json:"path,omitempty"`
+	WillBeError bool   `json:"will_be_error"`
+}
+
+// MarshalJSON renders diags as a JSON array of objects suitable for CI consumption,
+// one per diagnostic, carrying its Code (when known via DiagnosticCode) instead of
+// relying on consumers to pattern-match Detail text.
+func MarshalJSON(diags Diagnostics) ([]byte, error) {
+	out := make([]jsonDiagnostic, len(diags))
+
+	for i, d := range diags {
+		jd := jsonDiagnostic{
+			Summary:     d.Summary,
+			Detail:      d.Detail,
+			Path:        PathString(d.AttributePath),
+			WillBeError: isWillBeError(d.Diagnostic),
+		}
+
+		switch d.Severity {
+		case diag.Error:
+			jd.Severity = "error"
+		case diag.Warning:
+			jd.Severity = "warning"
+		}
+
+		if code, ok := DiagnosticCode(d); ok {
+			jd.Code = string(code)
+		}
+
+		out[i] = jd
+	}
+
+	return json.Marshal(out)
+}
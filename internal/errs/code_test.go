@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// TestDiagnosticCode_survivesWillBeError guards against a regression where a
+// WillBeError diagnostic's Code could no longer be recovered, because the code used to
+// be tracked in a map keyed on the diagnostic's pre-mutation identity, but willBeError
+// then mutated the diagnostic (appending to Detail, flipping Severity) before returning
+// it, so any later lookup by the caller's copy always missed.
+func TestDiagnosticCode_survivesWillBeError(t *testing.T) {
+	t.Parallel()
+
+	path := cty.Path{cty.GetAttrStep{Name: "foo"}}
+	d := NewAttributeRequiredWillBeError(path, "foo")
+
+	code, ok := DiagnosticCode(d)
+	if !ok || code != CodeAttributeRequired {
+		t.Fatalf("DiagnosticCode() = (%q, %v), want (%q, true)", code, ok, CodeAttributeRequired)
+	}
+
+	if !isWillBeError(d.Diagnostic) {
+		t.Fatalf("isWillBeError() = false, want true")
+	}
+
+	b, err := MarshalJSON(Diagnostics{d})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := string(b); !strings.Contains(got, `"code":"attribute_required"`) || !strings.Contains(got, `"will_be_error":true`) {
+		t.Errorf("MarshalJSON() = %s, want it to report the code and will_be_error", got)
+	}
+}
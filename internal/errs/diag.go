@@ -17,27 +17,27 @@ const (
 	summaryInvalidValueType = "Invalid value type"
 )
 
-func NewIncorrectValueTypeAttributeError(path cty.Path, expected string) diag.Diagnostic {
-	return NewAttributeErrorDiagnostic(
+func NewIncorrectValueTypeAttributeError(path cty.Path, expected string) Diagnostic {
+	return withCode(NewAttributeErrorDiagnostic(
 		path,
 		summaryInvalidValueType,
 		"Expected type to be "+expected,
-	)
+	), CodeInvalidValueType)
 }
 
-func NewInvalidValueAttributeErrorf(path cty.Path, format string, a ...any) diag.Diagnostic {
+func NewInvalidValueAttributeErrorf(path cty.Path, format string, a ...any) Diagnostic {
 	return NewInvalidValueAttributeError(
 		path,
 		fmt.Sprintf(format, a...),
 	)
 }
 
-func NewInvalidValueAttributeError(path cty.Path, detail string) diag.Diagnostic {
-	return NewAttributeErrorDiagnostic(
+func NewInvalidValueAttributeError(path cty.Path, detail string) Diagnostic {
+	return withCode(NewAttributeErrorDiagnostic(
 		path,
 		summaryInvalidValue,
 		detail,
-	)
+	), CodeInvalidValue)
 }
 
 func NewAttributeErrorDiagnostic(path cty.Path, summary, detail string) diag.Diagnostic {
@@ -77,8 +77,8 @@ func withPath(d diag.Diagnostic, path cty.Path) diag.Diagnostic {
 
 // NewAttributeConflictsWhenError returns an error diagnostic indicating that the attribute at the given path cannot be
 // specified when the attribute at otherPath has the given value.
-func NewAttributeConflictsWhenError(path, otherPath cty.Path, otherValue string) diag.Diagnostic {
-	return NewAttributeErrorDiagnostic(
+func NewAttributeConflictsWhenError(path, otherPath cty.Path, otherValue string) Diagnostic {
+	return withCode(NewAttributeErrorDiagnostic(
 		path,
 		"Invalid Attribute Combination",
 		fmt.Sprintf("Attribute %q cannot be specified when %q is %q.",
@@ -86,13 +86,13 @@ func NewAttributeConflictsWhenError(path, otherPath cty.Path, otherValue string)
 			PathString(otherPath),
 			otherValue,
 		),
-	)
+	), CodeAttributeConflict)
 }
 
 // NewAttributeRequiredWhenError returns an error diagnostic indicating that the attribute at neededPath is required when the
 // attribute at otherPath has the given value.
-func NewAttributeRequiredWhenError(neededPath, otherPath cty.Path, value string) diag.Diagnostic {
-	return NewAttributeErrorDiagnostic(
+func NewAttributeRequiredWhenError(neededPath, otherPath cty.Path, value string) Diagnostic {
+	return withCode(NewAttributeErrorDiagnostic(
 		otherPath,
 		"Invalid Attribute Combination",
 		fmt.Sprintf("Attribute %q must be specified when %q is %q.",
@@ -100,32 +100,32 @@ func NewAttributeRequiredWhenError(neededPath, otherPath cty.Path, value string)
 			PathString(otherPath),
 			value,
 		),
-	)
+	), CodeAttributeRequired)
 }
 
 // NewAtLeastOneOfChildrenError returns an error diagnostic indicating that at least on of the named children of
 // parentPath is required.
-func NewAtLeastOneOfChildrenError(parentPath cty.Path, paths ...cty.Path) diag.Diagnostic {
-	return NewAttributeErrorDiagnostic(
+func NewAtLeastOneOfChildrenError(parentPath cty.Path, paths ...cty.Path) Diagnostic {
+	return withCode(NewAttributeErrorDiagnostic(
 		parentPath,
 		"Invalid Attribute Combination",
 		fmt.Sprintf("At least one attribute out of [%s] must be specified", strings.Join(tfslices.ApplyToAll(paths, PathString), ", ")),
-	)
+	), CodeAtLeastOneOf)
 }
 
 // NewAttributeRequiredWhenError should only be used for apply-time validation, as it replicates
 // the functionality of a `Required` attribute
-func NewAttributeRequiredError(parentPath cty.Path, attrname string) diag.Diagnostic {
-	return NewAttributeErrorDiagnostic(
+func NewAttributeRequiredError(parentPath cty.Path, attrname string) Diagnostic {
+	return withCode(NewAttributeErrorDiagnostic(
 		parentPath,
 		"Missing required argument",
 		fmt.Sprintf("The argument %q is required, but no definition was found.", attrname),
-	)
+	), CodeAttributeRequired)
 }
 
 // NewAttributeRequiredWillBeError returns a warning diagnostic indicating that the attribute at the given path is required.
 // This is intended to be used for situations where the missing attribute will be an error in a future release.
-func NewAttributeRequiredWillBeError(parentPath cty.Path, attrname string) diag.Diagnostic {
+func NewAttributeRequiredWillBeError(parentPath cty.Path, attrname string) Diagnostic {
 	return willBeError(
 		NewAttributeRequiredError(parentPath, attrname),
 	)
@@ -134,7 +134,7 @@ func NewAttributeRequiredWillBeError(parentPath cty.Path, attrname string) diag.
 // NewAttributeConflictsWhenWillBeError returns a warning diagnostic indicating that the attribute at the given path cannot be
 // specified when the attribute at otherPath has the given value.
 // This is intended to be used for situations where the conflict will become an error in a future release.
-func NewAttributeConflictsWhenWillBeError(path, otherPath cty.Path, otherValue string) diag.Diagnostic {
+func NewAttributeConflictsWhenWillBeError(path, otherPath cty.Path, otherValue string) Diagnostic {
 	return willBeError(
 		NewAttributeConflictsWhenError(path, otherPath, otherValue),
 	)
@@ -176,7 +176,8 @@ func errorToWarning(d diag.Diagnostic) diag.Diagnostic {
 	return d
 }
 
-func willBeError(d diag.Diagnostic) diag.Diagnostic {
-	d.Detail += "\n\nThis will be an error in a future release."
-	return errorToWarning(d)
+func willBeError(d Diagnostic) Diagnostic {
+	d.Detail += willBeErrorSuffix
+	d.Diagnostic = errorToWarning(d.Diagnostic)
+	return d
 }
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Diagnostic augments a diag.Diagnostic with metadata that the SDKv2 type has no field
+// for, such as a source position (see NewAttributeErrorDiagnosticWithSource). It exists
+// so that metadata travels with the diagnostic value itself, rather than in a
+// package-level side table keyed by a hash of the diagnostic's own text, which can
+// silently collide between unrelated diagnostics that happen to render identical text.
+type Diagnostic struct {
+	diag.Diagnostic
+
+	// Range is the HCL source position this diagnostic refers to, if known.
+	Range *hcl.Range
+
+	// FunctionArgument is the provider-defined function argument index this
+	// diagnostic refers to, if any. See NewFunctionArgumentError.
+	FunctionArgument *int64
+
+	// Code is the stable, machine-readable identifier of the diagnostic, if the
+	// constructor that produced it recorded one. See DiagnosticCode.
+	Code *Code
+}
+
+// Diagnostics is a slice of Diagnostic, mirroring diag.Diagnostics.
+type Diagnostics []Diagnostic
+
+// Wrap adapts d, e.g. one produced by the plain path-based constructors in this
+// package, into a Diagnostic carrying no extra metadata.
+func Wrap(d diag.Diagnostic) Diagnostic {
+	return Diagnostic{Diagnostic: d}
+}
+
+// WrapAll adapts diags into Diagnostics carrying no extra metadata.
+func WrapAll(diags diag.Diagnostics) Diagnostics {
+	out := make(Diagnostics, len(diags))
+	for i, d := range diags {
+		out[i] = Wrap(d)
+	}
+	return out
+}
+
+// SDKv2 discards ds's metadata and returns the underlying diag.Diagnostics, for
+// returning from code that only knows about the SDKv2 type, such as a
+// ValidateRawResourceConfigFunc or CustomizeDiffFunc.
+func (ds Diagnostics) SDKv2() diag.Diagnostics {
+	out := make(diag.Diagnostics, len(ds))
+	for i, d := range ds {
+		out[i] = d.Diagnostic
+	}
+	return out
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package errstest provides test helpers for asserting on errs.Diagnostics produced
+// by the internal/errs constructors. Asserting on errs.Code, rather than on fragile
+// substring matches of Detail text, keeps tests stable across wording changes.
+package errstest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// ExpectDiagnosticCode fails t unless diags contains at least one diagnostic whose
+// recorded errs.Code matches code.
+func ExpectDiagnosticCode(t *testing.T, diags errs.Diagnostics, code errs.Code) {
+	t.Helper()
+
+	for _, d := range diags {
+		if got, ok := errs.DiagnosticCode(d); ok && got == code {
+			return
+		}
+	}
+
+	t.Errorf("expected a diagnostic with code %q, got %s", code, formatCodes(diags))
+}
+
+func formatCodes(diags errs.Diagnostics) string {
+	codes := make([]string, len(diags))
+	for i, d := range diags {
+		if code, ok := errs.DiagnosticCode(d); ok {
+			codes[i] = string(code)
+		} else {
+			codes[i] = "<no code>"
+		}
+	}
+	return "[" + strings.Join(codes, ", ") + "]"
+}
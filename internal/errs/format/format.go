@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package format renders errs.Diagnostics as human-readable text, in the style of
+// Terraform core's command/format package. It is intended for callers that consume
+// provider diagnostics outside of a `terraform apply` run (unit tests, standalone
+// tools) where the usual CLI rendering isn't available, but a source file is at hand.
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[1;31m"
+	colorYellow = "\033[1;33m"
+	colorFaint  = "\033[2m"
+)
+
+// FormatDiagnostics renders diags as a human-readable, colorized report. When a
+// diagnostic has an associated source position (see errs.NewAttributeErrorDiagnosticWithSource)
+// and sources contains the corresponding file, a short snippet of the offending HCL is
+// included: the file:line, a caret pointing at the offending column, and a line of
+// context on either side. Diagnostics with no source position (e.g. those built with
+// errs.WrapAll) are rendered without a snippet.
+func FormatDiagnostics(diags errs.Diagnostics, sources map[string][]byte) string {
+	var buf bytes.Buffer
+
+	for i, d := range diags {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		formatDiagnostic(&buf, d, sources)
+	}
+
+	return buf.String()
+}
+
+func formatDiagnostic(buf *bytes.Buffer, d errs.Diagnostic, sources map[string][]byte) {
+	color, label := colorRed, "Error"
+	if d.Severity == diag.Warning {
+		color, label = colorYellow, "Warning"
+	}
+
+	fmt.Fprintf(buf, "%s%s: %s%s\n", color, label, d.Summary, colorReset)
+
+	if d.Detail != "" {
+		fmt.Fprintf(buf, "\n%s\n", d.Detail)
+	}
+
+	if d.Range == nil {
+		return
+	}
+
+	src, ok := sources[d.Range.Filename]
+	if !ok {
+		return
+	}
+
+	fmt.Fprintf(buf, "\n%s  on %s line %d:%s\n", colorFaint, d.Range.Filename, d.Range.Start.Line, colorReset)
+	writeSnippet(buf, src, *d.Range)
+}
+
+// writeSnippet writes the source line containing rng, preceded and followed by one
+// line of context when available, with a caret pointing at rng's starting column.
+func writeSnippet(buf *bytes.Buffer, src []byte, rng hcl.Range) {
+	lines := strings.Split(string(src), "\n")
+	lineIdx := rng.Start.Line - 1
+	if lineIdx < 0 || lineIdx >= len(lines) {
+		return
+	}
+
+	for i := lineIdx - 1; i <= lineIdx+1; i++ {
+		if i < 0 || i >= len(lines) {
+			continue
+		}
+		fmt.Fprintf(buf, "%s%4d: %s%s\n", colorFaint, i+1, colorReset, lines[i])
+		if i == lineIdx {
+			col := rng.Start.Column
+			if col < 1 {
+				col = 1
+			}
+			fmt.Fprintf(buf, "%s      %s^%s\n", colorFaint, strings.Repeat(" ", col-1), colorReset)
+		}
+	}
+}
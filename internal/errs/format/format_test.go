@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package format
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestFormatDiagnostics_snippet(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("resource \"aws_instance\" \"test\" {\n  instance_type = 123\n}\n")
+	rng := &hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 2, Column: 19},
+		End:      hcl.Pos{Line: 2, Column: 22},
+	}
+	d := errs.NewAttributeErrorDiagnosticWithSource(
+		cty.Path{cty.GetAttrStep{Name: "instance_type"}},
+		"Invalid value type",
+		"Expected type to be string",
+		rng,
+	)
+
+	got := FormatDiagnostics(errs.Diagnostics{d}, map[string][]byte{"main.tf": src})
+
+	for _, want := range []string{"Error: Invalid value type", "main.tf line 2", "instance_type = 123", "^"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("FormatDiagnostics() output missing %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestFormatDiagnostics_noSource(t *testing.T) {
+	t.Parallel()
+
+	d := errs.Wrap(errs.NewAttributeErrorDiagnostic(
+		cty.Path{cty.GetAttrStep{Name: "instance_type"}},
+		"Invalid value type",
+		"Expected type to be string",
+	))
+
+	got := FormatDiagnostics(errs.Diagnostics{d}, nil)
+
+	if strings.Contains(got, "line") {
+		t.Errorf("FormatDiagnostics() rendered a snippet with no source available, got:\n%s", got)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// NewFunctionArgumentError returns an error Diagnostic for the function argument at argIndex.
+func NewFunctionArgumentError(argIndex int64, summary, detail string) Diagnostic {
+	return withFunctionArgument(NewErrorDiagnostic(summary, detail), argIndex)
+}
+
+// NewFunctionArgumentErrorf returns an error Diagnostic for the function argument at argIndex,
+// formatting detail with the given format and arguments.
+func NewFunctionArgumentErrorf(argIndex int64, format string, a ...any) Diagnostic {
+	return NewFunctionArgumentError(argIndex, summaryInvalidValue, fmt.Sprintf(format, a...))
+}
+
+// NewInvalidFunctionArgumentTypeError returns an error Diagnostic indicating that the function
+// argument at argIndex was not of the expected type.
+func NewInvalidFunctionArgumentTypeError(argIndex int64, expected string) Diagnostic {
+	return NewFunctionArgumentError(
+		argIndex,
+		summaryInvalidValueType,
+		"Expected type to be "+expected,
+	)
+}
+
+func withFunctionArgument(d diag.Diagnostic, argIndex int64) Diagnostic {
+	return Diagnostic{Diagnostic: d, FunctionArgument: &argIndex}
+}
+
+// ToFuncError converts diags into a *function.FuncError suitable for
+// `resp.Error = errs.ToFuncError(diags)` in a provider function's Run method. Diagnostics
+// carrying a FunctionArgument (see NewFunctionArgumentError) are built via
+// function.NewArgumentFuncError, so Terraform can point at the actual offending call
+// argument instead of just the function as a whole; the rest are built via
+// function.NewFuncError and merged in. Only error-severity diagnostics are included,
+// since FuncError has no concept of a warning. Use errs.WrapAll to adapt plain
+// diag.Diagnostics produced by the shared, path-based validation helpers before mixing
+// them in here.
+func ToFuncError(diags Diagnostics) *function.FuncError {
+	var funcErr *function.FuncError
+
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+
+		text := d.Summary
+		if d.Detail != "" {
+			text = fmt.Sprintf("%s: %s", d.Summary, d.Detail)
+		}
+
+		if d.FunctionArgument != nil {
+			funcErr = function.ConcatFuncErrors(funcErr, function.NewArgumentFuncError(*d.FunctionArgument, text))
+		} else {
+			funcErr = function.ConcatFuncErrors(funcErr, function.NewFuncError(text))
+		}
+	}
+
+	return funcErr
+}
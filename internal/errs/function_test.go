@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"testing"
+)
+
+// TestToFuncError_argumentIndex guards against a regression where the function argument
+// index was only ever appended as English prose to Detail, so a FuncError built from it
+// had no FunctionArgument of its own for Terraform to point at.
+func TestToFuncError_argumentIndex(t *testing.T) {
+	t.Parallel()
+
+	d := NewFunctionArgumentError(1, "Invalid value", "must not be empty")
+	if d.FunctionArgument == nil || *d.FunctionArgument != 1 {
+		t.Fatalf("NewFunctionArgumentError().FunctionArgument = %v, want 1", d.FunctionArgument)
+	}
+
+	funcErr := ToFuncError(Diagnostics{d})
+	if funcErr == nil {
+		t.Fatalf("ToFuncError() = nil, want an error")
+	}
+	if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 1 {
+		t.Errorf("ToFuncError().FunctionArgument = %v, want 1", funcErr.FunctionArgument)
+	}
+}
+
+func TestToFuncError_noArgumentIndex(t *testing.T) {
+	t.Parallel()
+
+	d := Wrap(NewAttributeErrorDiagnostic(nil, "Invalid value", "must not be empty"))
+
+	funcErr := ToFuncError(Diagnostics{d})
+	if funcErr == nil {
+		t.Fatalf("ToFuncError() = nil, want an error")
+	}
+	if funcErr.FunctionArgument != nil {
+		t.Errorf("ToFuncError().FunctionArgument = %v, want nil", funcErr.FunctionArgument)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// summaryAttributeDeprecated is the Summary NewAttributeDeprecatedDiagnostic uses, shared
+// with DedupeDeprecationWarnings so the two can't silently drift apart.
+const summaryAttributeDeprecated = "Attribute Deprecated"
+
+// NewAttributeDeprecatedDiagnostic returns a warning diagnostic indicating that the
+// attribute at path is deprecated, mirroring the plugin-framework's first-class
+// attribute deprecation handling for schemas defined via the SDKv2.
+func NewAttributeDeprecatedDiagnostic(path cty.Path, replacement, removalVersion string) diag.Diagnostic {
+	return NewAttributeWarningDiagnostic(
+		path,
+		summaryAttributeDeprecated,
+		deprecationDetail(path, replacement, removalVersion),
+	)
+}
+
+// NewAttributeRemovedError returns an error diagnostic indicating that the attribute at
+// path was removed in removedInVersion.
+func NewAttributeRemovedError(path cty.Path, removedInVersion, replacement string) diag.Diagnostic {
+	detail := fmt.Sprintf("Attribute %q was removed in %s.", PathString(path), removedInVersion)
+	if replacement != "" {
+		detail += fmt.Sprintf(" Use %q instead.", replacement)
+	}
+
+	return NewAttributeErrorDiagnostic(path, "Attribute Removed", detail)
+}
+
+func deprecationDetail(path cty.Path, replacement, removalVersion string) string {
+	detail := fmt.Sprintf("Attribute %q is deprecated", PathString(path))
+	if removalVersion != "" {
+		detail += fmt.Sprintf(" and will be removed in %s", removalVersion)
+	}
+	detail += "."
+	if replacement != "" {
+		detail += fmt.Sprintf(" Use %q instead.", replacement)
+	}
+	return detail
+}
+
+// LifecycleBuilder builds diagnostics that describe the deprecation lifecycle of a
+// single attribute. Construct one with Lifecycle.
+type LifecycleBuilder struct {
+	path           cty.Path
+	replacement    string
+	removalVersion string
+	removed        bool
+}
+
+// Lifecycle starts a LifecycleBuilder for the attribute at path.
+func Lifecycle(path cty.Path) *LifecycleBuilder {
+	return &LifecycleBuilder{path: path}
+}
+
+// Deprecated marks the attribute as deprecated in favor of replacement.
+func (b *LifecycleBuilder) Deprecated(replacement string) *LifecycleBuilder {
+	b.replacement = replacement
+	return b
+}
+
+// RemovedIn records version as the release in which the attribute is scheduled for
+// removal or, if Removed is also called, was removed. Whether Deprecated was called
+// does not affect which of the two Diagnostic reports.
+func (b *LifecycleBuilder) RemovedIn(version string) *LifecycleBuilder {
+	b.removalVersion = version
+	return b
+}
+
+// Removed marks the attribute as already removed, rather than merely deprecated.
+// RemovedIn should also be called to record the version in which it was removed.
+func (b *LifecycleBuilder) Removed() *LifecycleBuilder {
+	b.removed = true
+	return b
+}
+
+// Diagnostic renders the builder's state as a diag.Diagnostic: an error if Removed was
+// called, otherwise a deprecation warning.
+func (b *LifecycleBuilder) Diagnostic() diag.Diagnostic {
+	if b.removed {
+		return NewAttributeRemovedError(b.path, b.removalVersion, b.replacement)
+	}
+	return NewAttributeDeprecatedDiagnostic(b.path, b.replacement, b.removalVersion)
+}
+
+// DedupeDeprecationWarnings drops deprecation diagnostics (as produced by
+// NewAttributeDeprecatedDiagnostic or Lifecycle(...).Diagnostic()) that repeat, path for
+// path, an earlier diagnostic in diags. List indexes are ignored when comparing paths, so
+// a resource that emits the same deprecation for every element of a list or set only
+// surfaces it once.
+func DedupeDeprecationWarnings(diags diag.Diagnostics) diag.Diagnostics {
+	seen := make(map[string]bool, len(diags))
+	out := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		if d.Summary != summaryAttributeDeprecated {
+			out = append(out, d)
+			continue
+		}
+
+		key := dedupeKey(d)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, d)
+	}
+
+	return out
+}
+
+// dedupeKey normalizes d's path and Detail so that deprecations for distinct elements
+// of the same list or set (e.g. "items[0].foo" and "items[1].foo") produce the same
+// key. deprecationDetail bakes the indexed path straight into Detail, so normalizing
+// AttributePath alone isn't enough: the literal indexed path substring is also replaced
+// with its indexless form before comparing.
+func dedupeKey(d diag.Diagnostic) string {
+	indexless := indexlessPath(d.AttributePath)
+	detail := strings.ReplaceAll(d.Detail, PathString(d.AttributePath), PathString(indexless))
+	return PathString(indexless) + "\x00" + detail
+}
+
+func indexlessPath(path cty.Path) cty.Path {
+	out := make(cty.Path, 0, len(path))
+	for _, step := range path {
+		if _, ok := step.(cty.IndexStep); ok {
+			continue
+		}
+		out = append(out, step)
+	}
+	return out
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// TestDedupeDeprecationWarnings_collapsesListElements guards against a regression
+// where the same deprecation raised for every element of a list never collapsed,
+// because deprecationDetail bakes the indexed path into Detail and the old dedupe key
+// compared Detail verbatim.
+func TestDedupeDeprecationWarnings_collapsesListElements(t *testing.T) {
+	t.Parallel()
+
+	newDeprecation := func(index int) diag.Diagnostic {
+		path := cty.Path{
+			cty.GetAttrStep{Name: "items"},
+			cty.IndexStep{Key: cty.NumberIntVal(int64(index))},
+			cty.GetAttrStep{Name: "foo"},
+		}
+		return NewAttributeDeprecatedDiagnostic(path, "bar", "v6.0.0")
+	}
+
+	diags := diag.Diagnostics{newDeprecation(0), newDeprecation(1), newDeprecation(2)}
+
+	got := DedupeDeprecationWarnings(diags)
+	if len(got) != 1 {
+		t.Fatalf("DedupeDeprecationWarnings() returned %d diagnostics, want 1: %#v", len(got), got)
+	}
+}
+
+func TestDedupeDeprecationWarnings_distinctAttributesSurvive(t *testing.T) {
+	t.Parallel()
+
+	diags := diag.Diagnostics{
+		NewAttributeDeprecatedDiagnostic(cty.Path{cty.GetAttrStep{Name: "foo"}}, "bar", "v6.0.0"),
+		NewAttributeDeprecatedDiagnostic(cty.Path{cty.GetAttrStep{Name: "baz"}}, "qux", "v6.0.0"),
+	}
+
+	got := DedupeDeprecationWarnings(diags)
+	if len(got) != 2 {
+		t.Fatalf("DedupeDeprecationWarnings() returned %d diagnostics, want 2: %#v", len(got), got)
+	}
+}
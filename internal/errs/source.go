@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/hcl/v2"
+)
+
+// NewAttributeErrorDiagnosticWithSource returns an error Diagnostic for the given
+// attribute path, carrying rng (when non-nil) so that format.FormatDiagnostics can
+// render the offending HCL.
+func NewAttributeErrorDiagnosticWithSource(path cty.Path, summary, detail string, rng *hcl.Range) Diagnostic {
+	return Diagnostic{
+		Diagnostic: NewAttributeErrorDiagnostic(path, summary, detail),
+		Range:      rng,
+	}
+}
+
+// NewAttributeWarningDiagnosticWithSource returns a warning Diagnostic for the given
+// attribute path, carrying rng (when non-nil) so that format.FormatDiagnostics can
+// render the offending HCL.
+func NewAttributeWarningDiagnosticWithSource(path cty.Path, summary, detail string, rng *hcl.Range) Diagnostic {
+	return Diagnostic{
+		Diagnostic: NewAttributeWarningDiagnostic(path, summary, detail),
+		Range:      rng,
+	}
+}
+
+// NewAttributeConflictsWhenErrorWithSource is NewAttributeConflictsWhenError, additionally
+// carrying rng (when non-nil) so that format.FormatDiagnostics can render the offending HCL.
+func NewAttributeConflictsWhenErrorWithSource(path, otherPath cty.Path, otherValue string, rng *hcl.Range) Diagnostic {
+	d := NewAttributeConflictsWhenError(path, otherPath, otherValue)
+	d.Range = rng
+	return d
+}
+
+// NewAtLeastOneOfChildrenErrorWithSource is NewAtLeastOneOfChildrenError, additionally
+// carrying rng (when non-nil) so that format.FormatDiagnostics can render the offending HCL.
+func NewAtLeastOneOfChildrenErrorWithSource(parentPath cty.Path, rng *hcl.Range, paths ...cty.Path) Diagnostic {
+	d := NewAtLeastOneOfChildrenError(parentPath, paths...)
+	d.Range = rng
+	return d
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/hcl/v2"
+)
+
+// TestNewAttributeConflictsWhenErrorWithSource_carriesCodeAndRange guards against a
+// regression where the WithSource variants were never added for the two constructors
+// the request named, leaving them with no way to carry a source position through to
+// format.FormatDiagnostics.
+func TestNewAttributeConflictsWhenErrorWithSource_carriesCodeAndRange(t *testing.T) {
+	t.Parallel()
+
+	rng := &hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1}, End: hcl.Pos{Line: 1}}
+	d := NewAttributeConflictsWhenErrorWithSource(
+		cty.Path{cty.GetAttrStep{Name: "a"}},
+		cty.Path{cty.GetAttrStep{Name: "b"}},
+		"x",
+		rng,
+	)
+
+	if d.Range != rng {
+		t.Errorf("NewAttributeConflictsWhenErrorWithSource().Range = %v, want %v", d.Range, rng)
+	}
+	if code, ok := DiagnosticCode(d); !ok || code != CodeAttributeConflict {
+		t.Errorf("DiagnosticCode() = (%q, %v), want (%q, true)", code, ok, CodeAttributeConflict)
+	}
+}
+
+func TestNewAtLeastOneOfChildrenErrorWithSource_carriesCodeAndRange(t *testing.T) {
+	t.Parallel()
+
+	rng := &hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1}, End: hcl.Pos{Line: 1}}
+	d := NewAtLeastOneOfChildrenErrorWithSource(
+		cty.Path{cty.GetAttrStep{Name: "parent"}},
+		rng,
+		cty.Path{cty.GetAttrStep{Name: "a"}},
+		cty.Path{cty.GetAttrStep{Name: "b"}},
+	)
+
+	if d.Range != rng {
+		t.Errorf("NewAtLeastOneOfChildrenErrorWithSource().Range = %v, want %v", d.Range, rng)
+	}
+	if code, ok := DiagnosticCode(d); !ok || code != CodeAtLeastOneOf {
+		t.Errorf("DiagnosticCode() = (%q, %v), want (%q, true)", code, ok, CodeAtLeastOneOf)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validate provides a fluent cross-attribute validation DSL built on top of
+// the diag.Diagnostic constructors in internal/errs. It exists so that resources stop
+// hand-rolling the same conditional-required/conflict checks against cty.Path values;
+// instead a resource's ValidateRawResourceConfigFunc (or similar) builds a small set of
+// rules once and calls Validate against the config/state cty.Value.
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// Rule produces diagnostics by inspecting root.
+type Rule interface {
+	Validate(root cty.Value) diag.Diagnostics
+}
+
+// Validate runs every rule against root and concatenates the resulting diagnostics.
+func Validate(root cty.Value, rules ...Rule) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, r := range rules {
+		diags = append(diags, r.Validate(root)...)
+	}
+	return diags
+}
+
+// Condition tests the value at a ConditionalRule's path.
+type Condition interface {
+	match(v cty.Value) bool
+	String() string
+}
+
+type equals struct {
+	value string
+}
+
+// Equals returns a Condition that matches a known, non-null string value equal to value.
+func Equals(value string) Condition {
+	return equals{value: value}
+}
+
+func (e equals) match(v cty.Value) bool {
+	return v.IsKnown() && !v.IsNull() && v.Type() == cty.String && v.AsString() == e.value
+}
+
+func (e equals) String() string {
+	return e.value
+}
+
+// ConditionalRule requires or conflicts-with other attributes when the value at path
+// matches a Condition. Build one with When.
+type ConditionalRule struct {
+	path      cty.Path
+	cond      Condition
+	requires  []cty.Path
+	conflicts []cty.Path
+}
+
+// When starts a ConditionalRule keyed on the attribute at path matching cond.
+func When(path cty.Path, cond Condition) *ConditionalRule {
+	return &ConditionalRule{path: path, cond: cond}
+}
+
+// Require adds path to the set of attributes required when the rule's condition matches.
+func (r *ConditionalRule) Require(path cty.Path) *ConditionalRule {
+	r.requires = append(r.requires, path)
+	return r
+}
+
+// ConflictWith adds path to the set of attributes that must be absent when the rule's
+// condition matches.
+func (r *ConditionalRule) ConflictWith(path cty.Path) *ConditionalRule {
+	r.conflicts = append(r.conflicts, path)
+	return r
+}
+
+// Validate implements Rule.
+func (r *ConditionalRule) Validate(root cty.Value) diag.Diagnostics {
+	v, err := r.path.Apply(root)
+	if err != nil || !r.cond.match(v) {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	for _, req := range r.requires {
+		rv, err := req.Apply(root)
+		if err != nil || rv.IsNull() || (rv.IsKnown() && rv.Type() == cty.String && rv.AsString() == "") {
+			diags = append(diags, errs.NewAttributeRequiredWhenError(req, r.path, r.cond.String()).Diagnostic)
+		}
+	}
+
+	for _, conf := range r.conflicts {
+		cv, err := conf.Apply(root)
+		if err == nil && !cv.IsNull() {
+			diags = append(diags, errs.NewAttributeConflictsWhenError(conf, r.path, r.cond.String()).Diagnostic)
+		}
+	}
+
+	return diags
+}
+
+// ExactlyOneOfRule requires that exactly one of a parent attribute's named children be
+// set. Build one with ExactlyOneOf.
+type ExactlyOneOfRule struct {
+	parent   cty.Path
+	children []cty.Path
+}
+
+// ExactlyOneOf returns a rule requiring that exactly one of children be set.
+func ExactlyOneOf(parent cty.Path, children ...cty.Path) *ExactlyOneOfRule {
+	return &ExactlyOneOfRule{parent: parent, children: children}
+}
+
+// Validate implements Rule.
+func (r *ExactlyOneOfRule) Validate(root cty.Value) diag.Diagnostics {
+	var set []cty.Path
+
+	for _, child := range r.children {
+		v, err := child.Apply(root)
+		if err == nil && !v.IsNull() {
+			set = append(set, child)
+		}
+	}
+
+	switch len(set) {
+	case 0:
+		return diag.Diagnostics{errs.NewAtLeastOneOfChildrenError(r.parent, r.children...).Diagnostic}
+	case 1:
+		return nil
+	default:
+		var diags diag.Diagnostics
+		for _, extra := range set[1:] {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				extra,
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Only one of %q and %q can be specified.", errs.PathString(set[0]), errs.PathString(extra)),
+			))
+		}
+		return diags
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func attrPath(name string) cty.Path {
+	return cty.Path{cty.GetAttrStep{Name: name}}
+}
+
+func TestConditionalRule(t *testing.T) {
+	t.Parallel()
+
+	rule := When(attrPath("mode"), Equals("advanced")).
+		Require(attrPath("advanced_config")).
+		ConflictWith(attrPath("simple_config"))
+
+	root := cty.ObjectVal(map[string]cty.Value{
+		"mode":            cty.StringVal("advanced"),
+		"advanced_config": cty.NullVal(cty.String),
+		"simple_config":   cty.StringVal("x"),
+	})
+
+	if diags := rule.Validate(root); len(diags) != 2 {
+		t.Fatalf("Validate() returned %d diagnostics, want 2: %#v", len(diags), diags)
+	}
+}
+
+func TestConditionalRule_noMatch(t *testing.T) {
+	t.Parallel()
+
+	rule := When(attrPath("mode"), Equals("advanced")).Require(attrPath("advanced_config"))
+
+	root := cty.ObjectVal(map[string]cty.Value{
+		"mode":            cty.StringVal("simple"),
+		"advanced_config": cty.NullVal(cty.String),
+	})
+
+	if diags := rule.Validate(root); len(diags) != 0 {
+		t.Fatalf("Validate() = %#v, want no diagnostics", diags)
+	}
+}
+
+// TestExactlyOneOf_bothSet guards against a regression where the "more than one set"
+// diagnostic reused NewAttributeConflictsWhenError with a fake literal "set" value,
+// producing a message implying the attribute's literal value was the string "set".
+func TestExactlyOneOf_bothSet(t *testing.T) {
+	t.Parallel()
+
+	rule := ExactlyOneOf(attrPath("parent"), attrPath("a"), attrPath("b"))
+
+	root := cty.ObjectVal(map[string]cty.Value{
+		"parent": cty.NullVal(cty.String),
+		"a":      cty.StringVal("x"),
+		"b":      cty.StringVal("y"),
+	})
+
+	diags := rule.Validate(root)
+	if len(diags) != 1 {
+		t.Fatalf("Validate() returned %d diagnostics, want 1: %#v", len(diags), diags)
+	}
+
+	detail := diags[0].Detail
+	if !strings.Contains(detail, "Only one of") {
+		t.Errorf("Validate() detail = %q, want it to explain only one may be set", detail)
+	}
+	if strings.Contains(detail, `is "set"`) {
+		t.Errorf("Validate() detail = %q, should not claim the attribute's value is the literal string \"set\"", detail)
+	}
+}
+
+func TestExactlyOneOf_noneSet(t *testing.T) {
+	t.Parallel()
+
+	rule := ExactlyOneOf(attrPath("parent"), attrPath("a"), attrPath("b"))
+
+	root := cty.ObjectVal(map[string]cty.Value{
+		"parent": cty.NullVal(cty.String),
+		"a":      cty.NullVal(cty.String),
+		"b":      cty.NullVal(cty.String),
+	})
+
+	if diags := rule.Validate(root); len(diags) != 1 {
+		t.Fatalf("Validate() returned %d diagnostics, want 1: %#v", len(diags), diags)
+	}
+}
+
+func TestExactlyOneOf_oneSet(t *testing.T) {
+	t.Parallel()
+
+	rule := ExactlyOneOf(attrPath("parent"), attrPath("a"), attrPath("b"))
+
+	root := cty.ObjectVal(map[string]cty.Value{
+		"parent": cty.NullVal(cty.String),
+		"a":      cty.StringVal("x"),
+		"b":      cty.NullVal(cty.String),
+	})
+
+	if diags := rule.Validate(root); len(diags) != 0 {
+		t.Fatalf("Validate() = %#v, want no diagnostics", diags)
+	}
+}